@@ -0,0 +1,29 @@
+package main
+
+// Application is the struct-tag-validated shape of an applicant intake
+// submission - the example the struct-tag engine (tagvalidate.go) was
+// built for. Field order matches the three lines run() reads from an
+// issue body.
+type Application struct {
+	Email      string `validate:"required,email" section:"Email"`
+	AccountURL string `validate:"required,oneP_url" section:"AccountURL"`
+	Role       string `validate:"required|oneof=Founder or Owner,Team Member or Employee,Project Lead,Core Maintainer,Developer,Organizer or Admin,Program Manager" section:"Role"`
+}
+
+// ParseApplication builds an Application from an issue body's lines,
+// positionally: email, then account URL, then role. Missing lines are
+// left blank so Validate can report them as required-but-empty.
+func ParseApplication(lines []string) Application {
+	line := func(i int) string {
+		if i < len(lines) {
+			return lines[i]
+		}
+		return ""
+	}
+
+	return Application{
+		Email:      line(0),
+		AccountURL: line(1),
+		Role:       line(2),
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	zeroWidthRegex  = regexp.MustCompile(`[\x{200B}-\x{200D}\x{FEFF}]`)
+	bidiRegex       = regexp.MustCompile(`[\x{202A}-\x{202E}\x{2066}-\x{2069}]`)
+	privateUseRegex = regexp.MustCompile(`[\x{E000}-\x{F8FF}\x{F0000}-\x{FFFFD}\x{100000}-\x{10FFFD}]`)
+)
+
+func init() {
+	// Expand the legacy emoji regex to cover regional indicators (flag
+	// sequences), the text/emoji variation selector, and the current
+	// Unicode 15 blocks, so ZWJ-joined sequences like family/profession
+	// emoji are caught as a whole rather than slipping through as
+	// "unrecognized" codepoints.
+	emojiRegex = regexp.MustCompile(`[\x{1F1E6}-\x{1F1FF}\x{1F300}-\x{1F5FF}\x{1F600}-\x{1F64F}\x{1F680}-\x{1F6FF}\x{1F700}-\x{1F77F}\x{1F780}-\x{1F7FF}\x{1F800}-\x{1F8FF}\x{1F900}-\x{1F9FF}\x{1FA00}-\x{1FA6F}\x{1FA70}-\x{1FAFF}\x{1FB00}-\x{1FBFF}\x{2600}-\x{27BF}\x{FE0F}]`)
+}
+
+// ContentPolicy tunes which classes of content IsRegularString (via Scan)
+// rejects, so e.g. a project description can allow emoji while a project
+// name does not. The zero value rejects emoji and reserves no brand
+// tokens, so it does not run the confusable check at all.
+type ContentPolicy struct {
+	// AllowEmoji permits emoji/pictograph characters through.
+	AllowEmoji bool
+	// ReservedTokens are brand strings (e.g. "1password") that must not
+	// appear even as Unicode confusables of themselves.
+	ReservedTokens []string
+}
+
+// DefaultContentPolicy is the policy IsRegularString applies: no emoji, and
+// the project's own brand tokens are reserved against confusable spoofing.
+var DefaultContentPolicy = ContentPolicy{
+	AllowEmoji:     false,
+	ReservedTokens: []string{"1password", "1pw"},
+}
+
+// Scan rejects zero-width characters, bidi control overrides (the "Trojan
+// Source" attack class), private-use-area glyphs, and - per the policy -
+// emoji and confusable spellings of reserved brand tokens. It returns a
+// *ValidationError with a distinct code per failure so reviewers can see
+// why content was rejected.
+func (p ContentPolicy) Scan(value string) error {
+	if zeroWidthRegex.MatchString(value) {
+		return codedError(value, "content.zero_width", nil)
+	}
+
+	if bidiRegex.MatchString(value) {
+		return codedError(value, "content.bidi_override", nil)
+	}
+
+	if privateUseRegex.MatchString(value) {
+		return codedError(value, "content.private_use", nil)
+	}
+
+	if !p.AllowEmoji && emojiRegex.MatchString(value) {
+		return codedError(value, "validation.string.has_emoji", nil)
+	}
+
+	if token, ok := p.confusableReservedToken(value); ok {
+		return codedError(value, "content.reserved_token", map[string]any{"token": token})
+	}
+
+	return nil
+}
+
+// confusableSkeletons maps common lookalike runes (Cyrillic, Greek, and a
+// few other scripts) to the ASCII Latin letter they're spoofing, per the
+// Unicode "confusables" class of homograph attack. It's a small, curated
+// subset of the full Unicode confusables table, covering the scripts most
+// often used to spoof Latin brand names.
+var confusableSkeletons = map[rune]rune{
+	// Cyrillic
+	'а': 'a', 'е': 'e', 'і': 'i', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y',
+	'х': 'x', 'ѕ': 's', 'ј': 'j', 'ԁ': 'd', 'ԛ': 'q', 'ѡ': 'w',
+	'А': 'a', 'Е': 'e', 'О': 'o', 'Р': 'p', 'С': 'c', 'Т': 't', 'Х': 'x',
+	// Greek
+	'α': 'a', 'β': 'b', 'ε': 'e', 'ι': 'i', 'κ': 'k', 'ο': 'o', 'ρ': 'p',
+	'τ': 't', 'υ': 'y', 'χ': 'x', 'Α': 'a', 'Β': 'b', 'Ε': 'e', 'Ζ': 'z',
+	'Η': 'h', 'Ι': 'i', 'Κ': 'k', 'Μ': 'm', 'Ν': 'n', 'Ο': 'o', 'Ρ': 'p',
+	'Τ': 't', 'Υ': 'y', 'Χ': 'x',
+	// full-width Latin (e.g. U+FF41 fullwidth "a")
+	'ａ': 'a', 'ｂ': 'b', 'ｃ': 'c', 'ｄ': 'd', 'ｅ': 'e', 'ｏ': 'o', 'ｐ': 'p',
+	'ｓ': 's', 'ｗ': 'w',
+}
+
+// skeleton renders the confusable-skeleton form of s: each rune with a
+// known Latin lookalike is replaced by that Latin letter, everything else
+// is lowercased as-is. It also reports whether any substitution happened,
+// so callers can tell a genuine homograph from plain ASCII text.
+func skeleton(s string) (string, bool) {
+	var b strings.Builder
+	hadConfusable := false
+
+	for _, r := range s {
+		if mapped, ok := confusableSkeletons[r]; ok {
+			b.WriteRune(mapped)
+			hadConfusable = true
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String(), hadConfusable
+}
+
+// confusableReservedToken reports whether value contains a homograph
+// spelling of a reserved token - i.e. it matches the token once lookalike
+// characters are mapped back to Latin, but does NOT match it literally.
+// A plain, correctly-spelled ASCII mention of the brand (no lookalike
+// runes at all) is not a confusable and is left alone.
+func (p ContentPolicy) confusableReservedToken(value string) (string, bool) {
+	skel, hadConfusable := skeleton(value)
+	if !hadConfusable {
+		return "", false
+	}
+
+	lowered := strings.ToLower(value)
+
+	for _, token := range p.ReservedTokens {
+		tokenLower := strings.ToLower(token)
+		if strings.Contains(skel, tokenLower) && !strings.Contains(lowered, tokenLower) {
+			return token, true
+		}
+	}
+
+	return "", false
+}
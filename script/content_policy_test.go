@@ -0,0 +1,30 @@
+package main
+
+import "testing"
+
+func TestContentPolicyScan(t *testing.T) {
+	cases := []struct {
+		name      string
+		policy    ContentPolicy
+		in        string
+		wantError bool
+	}{
+		{"plain text", DefaultContentPolicy, "just a normal project description", false},
+		{"zero-width character", DefaultContentPolicy, "hello​world", true},
+		{"bidi override", DefaultContentPolicy, "hello‮world", true},
+		{"private use area", DefaultContentPolicy, "helloworld", true},
+		{"emoji rejected by default", DefaultContentPolicy, "great project \U0001F389", true},
+		{"emoji allowed when policy opts in", ContentPolicy{AllowEmoji: true}, "great project \U0001F389", false},
+		{"legitimate brand mention is not confusable", DefaultContentPolicy, "I love 1Password for teams", false},
+		{"confusable brand spoof is rejected", DefaultContentPolicy, "1pаssword for teams", true}, // Cyrillic а
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Scan(tc.in)
+			if (err != nil) != tc.wantError {
+				t.Errorf("Scan(%q) error = %v, wantError %v", tc.in, err, tc.wantError)
+			}
+		})
+	}
+}
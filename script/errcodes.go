@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// defaultCatalog holds the built-in English message templates, keyed by
+// ValidationError.ID. Params are substituted with "{name}" placeholders.
+// Hosts embedding a translator (go-i18n or similar) can ignore this catalog
+// entirely and supply their own via ValidationError.Translate.
+var defaultCatalog = map[string]string{
+	"validation.required":             "is empty",
+	"validation.email.invalid":        "is an invalid email",
+	"validation.url.invalid":          "is an invalid URL",
+	"validation.url.scheme":           "must use \"http\" or \"https\" scheme",
+	"validation.account_url.invalid":  "is an invalid 1Password account URL",
+	"validation.project_role.invalid": "is an invalid project role",
+	"validation.checkbox.unparseable": "could not parse checkbox",
+	"validation.checked.required":     "must be checked",
+	"validation.number.unparseable":   "could not be parsed into a number",
+	"validation.bool.unparseable":     "could not be parsed into a boolean",
+	"validation.string.has_url":       "cannot contain URLs",
+	"validation.string.has_email":     "cannot contain email addresses",
+	"validation.string.has_emoji":     "cannot contain emoji characters",
+	"validation.oneof.invalid":        "must be one of {options}",
+	"validation.unknown_validator":    "unknown validator {name}",
+	"url.idn.invalid":                 "is an invalid hostname",
+	"url.idn.mixed_script":            "mixes characters from different alphabets, which is not allowed",
+	"url.tld.not_allowed":             "does not use an allowed domain",
+	"url.has_userinfo":                "must not contain a username or password",
+	"url.has_port":                    "must not specify a port",
+	"url.has_path":                    "must not contain a path",
+	"url.has_query":                   "must not contain a query string",
+	"url.has_fragment":                "must not contain a fragment",
+	"content.zero_width":              "cannot contain zero-width characters",
+	"content.bidi_override":           "cannot contain bidirectional control characters",
+	"content.private_use":             "cannot contain private-use-area characters",
+	"content.reserved_token":          "cannot contain a confusable spelling of \"{token}\"",
+	"content.sanitize_failed":         "could not be parsed",
+}
+
+// renderDefault fills in a catalog template with params, falling back to the
+// raw ID if no template is registered.
+func renderDefault(id string, params map[string]any) string {
+	template, ok := defaultCatalog[id]
+	if !ok {
+		return id
+	}
+
+	for name, value := range params {
+		template = strings.ReplaceAll(template, "{"+name+"}", fmt.Sprintf("%v", value))
+	}
+
+	return template
+}
+
+// codedError builds a *ValidationError carrying a stable ID and its English
+// rendering, for use as the error return of a ValidatorFunc.
+func codedError(value, id string, params map[string]any) *ValidationError {
+	return &ValidationError{
+		Value:   value,
+		ID:      id,
+		Params:  params,
+		Message: renderDefault(id, params),
+	}
+}
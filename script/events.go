@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// EventHandler receives the payload emitted for a topic.
+type EventHandler func(ctx context.Context, payload any)
+
+// Subscription identifies a single Subscribe call so it can later be
+// passed to Unsubscribe.
+type Subscription struct {
+	topic string
+	id    uint64
+}
+
+type subscriber struct {
+	id      uint64
+	handler EventHandler
+}
+
+// EventBus is a minimal topic-based pub/sub bus. The zero value is not
+// usable; construct one with NewEventBus. A synchronous bus (workers == 0)
+// runs handlers inline on the Emit goroutine, which is convenient for
+// tests; a bus created with workers > 0 fans Emit out across a bounded
+// pool instead, so production handlers run off the caller's goroutine.
+// The pool's queue is bounded too: Emit blocks while it is full.
+type EventBus struct {
+	mu          sync.Mutex
+	subscribers map[string][]subscriber
+	nextID      uint64
+
+	jobs chan job
+	wg   sync.WaitGroup
+}
+
+type job struct {
+	ctx     context.Context
+	handler EventHandler
+	payload any
+}
+
+// NewEventBus creates a bus. workers <= 0 makes Emit synchronous; workers >
+// 0 starts that many goroutines draining a bounded queue.
+func NewEventBus(workers int) *EventBus {
+	bus := &EventBus{subscribers: make(map[string][]subscriber)}
+
+	if workers > 0 {
+		bus.jobs = make(chan job, workers*8)
+		for i := 0; i < workers; i++ {
+			bus.wg.Add(1)
+			go bus.worker()
+		}
+	}
+
+	return bus
+}
+
+func (b *EventBus) worker() {
+	defer b.wg.Done()
+	for j := range b.jobs {
+		j.handler(j.ctx, j.payload)
+	}
+}
+
+// Subscribe registers handler to run whenever topic is emitted.
+func (b *EventBus) Subscribe(topic string, handler EventHandler) Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	sub := subscriber{id: b.nextID, handler: handler}
+	b.subscribers[topic] = append(b.subscribers[topic], sub)
+
+	return Subscription{topic: topic, id: sub.id}
+}
+
+// Unsubscribe removes a previously registered handler.
+func (b *EventBus) Unsubscribe(sub Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subs := b.subscribers[sub.topic]
+	for i, s := range subs {
+		if s.id == sub.id {
+			b.subscribers[sub.topic] = append(subs[:i], subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Emit notifies every handler subscribed to topic. In synchronous mode
+// (see NewEventBus) handlers run inline before Emit returns; otherwise
+// they're queued onto the worker pool and Emit returns once every handler
+// is queued, waiting for room whenever the queue (workers*8 jobs) is full.
+func (b *EventBus) Emit(ctx context.Context, topic string, payload any) {
+	b.mu.Lock()
+	subs := append([]subscriber(nil), b.subscribers[topic]...)
+	b.mu.Unlock()
+
+	for _, s := range subs {
+		if b.jobs == nil {
+			s.handler(ctx, payload)
+			continue
+		}
+		b.jobs <- job{ctx: ctx, handler: s.handler, payload: payload}
+	}
+}
+
+// Close stops accepting new work and waits for the worker pool to drain.
+// It is a no-op for a synchronous bus.
+func (b *EventBus) Close() {
+	if b.jobs == nil {
+		return
+	}
+	close(b.jobs)
+	b.wg.Wait()
+}
+
+// applicationEvents is the bus wired into the validation flow below. It
+// defaults to synchronous so `go test` doesn't need to coordinate with
+// background goroutines; production entry points can swap in a pooled bus
+// with SetApplicationEvents before calling ParseInput.
+var applicationEvents = NewEventBus(0)
+
+// SetApplicationEvents replaces the bus used by the validation flow, e.g.
+// to switch to a pooled, asynchronous bus in production.
+func SetApplicationEvents(bus *EventBus) {
+	applicationEvents = bus
+}
+
+const (
+	// EventApplicationParsed fires once raw intake fields have been
+	// coerced into a struct, before validation rules run.
+	EventApplicationParsed = "application.parsed"
+	// EventApplicationValidated fires when Validate finds no errors.
+	EventApplicationValidated = "application.validated"
+	// EventApplicationRejected fires when Validate returns one or more
+	// ValidationError values.
+	EventApplicationRejected = "application.rejected"
+)
+
+// ValidateAndEmit runs Validate and reports the outcome on
+// applicationEvents, so maintainers can add reviewers, Slack
+// notifications, or metrics without touching the validator core.
+func ValidateAndEmit(ctx context.Context, v any) []ValidationError {
+	applicationEvents.Emit(ctx, EventApplicationParsed, v)
+
+	errs := Validate(v)
+	if len(errs) == 0 {
+		applicationEvents.Emit(ctx, EventApplicationValidated, v)
+	} else {
+		applicationEvents.Emit(ctx, EventApplicationRejected, errs)
+	}
+
+	return errs
+}
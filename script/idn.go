@@ -0,0 +1,167 @@
+package main
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+)
+
+// accountURLAllowedTLDs is the configurable set of TLDs ParseAccountUrl
+// accepts for a 1Password account hostname. SetAccountURLTLDs lets hosts
+// widen this as new regional TLDs come online without touching the
+// parsing logic.
+var accountURLAllowedTLDs = []string{"com", "ca", "eu"}
+
+// SetAccountURLTLDs replaces the TLD allow-list used by ParseAccountUrl.
+func SetAccountURLTLDs(tlds []string) {
+	accountURLAllowedTLDs = tlds
+}
+
+var idnProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.StrictDomainName(true),
+)
+
+// normalizeHostname runs an IDN hostname through the strict Lookup
+// profile, rejecting homograph attacks built from mixed-script labels,
+// and canonicalizes it to lowercase ASCII. It returns a code suitable for
+// codedError on failure.
+func normalizeHostname(host string) (string, string) {
+	ascii, err := idnProfile.ToASCII(strings.ToLower(host))
+	if err != nil {
+		return host, "url.idn.invalid"
+	}
+
+	for _, label := range strings.Split(ascii, ".") {
+		if !isSingleScriptLabel(label) {
+			return host, "url.idn.mixed_script"
+		}
+	}
+
+	return ascii, ""
+}
+
+// isSingleScriptLabel rejects labels that mix Latin letters with other
+// scripts once decoded from punycode back to the label's original runes -
+// the "Cyrillic а mixed with Latin" class of homograph attack. ASCII
+// letters count as Latin; other ASCII characters (digits, hyphens,
+// punctuation) and non-letter runes are script-neutral and allowed
+// alongside either script.
+func isSingleScriptLabel(label string) bool {
+	decoded, err := idna.ToUnicode(label)
+	if err != nil {
+		decoded = label
+	}
+
+	sawLatin, sawOther := false, false
+	for _, r := range decoded {
+		switch {
+		case r < utf8RuneSelf && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'):
+			sawLatin = true
+		case r < utf8RuneSelf:
+			// ASCII digits/hyphen/punctuation are script-neutral.
+		case unicode.Is(unicode.Latin, r):
+			sawLatin = true
+		case unicode.IsLetter(r):
+			sawOther = true
+		}
+	}
+
+	return !(sawLatin && sawOther)
+}
+
+const utf8RuneSelf = 0x80
+
+// tldAllowed reports whether host ends in one of accountURLAllowedTLDs.
+func tldAllowed(host string) bool {
+	for _, tld := range accountURLAllowedTLDs {
+		if strings.HasSuffix(host, "."+tld) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAccountDomain reports whether host is "1password.<tld>" itself, or a
+// subdomain of it, for one of the allowed TLDs - the domain-stem check
+// the original `\.1password\.(com|ca|eu)$` regex enforced, except that the
+// regex also required a subdomain and this accepts the bare domain. Being
+// TLD-allowed alone (tldAllowed) isn't enough: "evil.com" and
+// "phishing-1password.com" both end in an allowed TLD but aren't
+// 1Password account hosts.
+func isAccountDomain(host string) bool {
+	for _, tld := range accountURLAllowedTLDs {
+		base := "1password." + tld
+		if host == base || strings.HasSuffix(host, "."+base) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseStrictAccountURL applies the structural checks ParseAccountUrl
+// needs on top of hostname normalization: no userinfo, no explicit port,
+// no path/query/fragment beyond an optional trailing slash.
+func parseStrictAccountURL(raw string) (hostname string, code string) {
+	if !strings.HasPrefix(raw, "http://") && !strings.HasPrefix(raw, "https://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw, "url.idn.invalid"
+	}
+
+	if u.User != nil {
+		return raw, "url.has_userinfo"
+	}
+	if u.Port() != "" {
+		return raw, "url.has_port"
+	}
+	if u.Path != "" && u.Path != "/" {
+		return raw, "url.has_path"
+	}
+	if u.RawQuery != "" {
+		return raw, "url.has_query"
+	}
+	if u.Fragment != "" {
+		return raw, "url.has_fragment"
+	}
+
+	host, code := normalizeHostname(u.Hostname())
+	if code != "" {
+		return raw, code
+	}
+
+	if !tldAllowed(host) {
+		return raw, "url.tld.not_allowed"
+	}
+
+	if !isAccountDomain(host) {
+		return raw, "validation.account_url.invalid"
+	}
+
+	return host, ""
+}
+
+// normalizeEmailDomain applies the same IDN/homograph checks to the
+// domain portion of an email address, so "foo@аpple.com"-style lookalikes
+// (Cyrillic "а" mixed into a Latin label)
+// are flagged the same way a lookalike account URL would be.
+func normalizeEmailDomain(address string) (string, string) {
+	at := strings.LastIndexByte(address, '@')
+	if at == -1 {
+		return address, ""
+	}
+
+	local, domain := address[:at], address[at+1:]
+	host, code := normalizeHostname(domain)
+	if code != "" {
+		return address, code
+	}
+
+	return local + "@" + host, ""
+}
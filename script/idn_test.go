@@ -0,0 +1,67 @@
+package main
+
+import "testing"
+
+func TestIsSingleScriptLabel(t *testing.T) {
+	cases := []struct {
+		label string
+		want  bool
+	}{
+		{"password", true},
+		{"1password", true},
+		{"my-account-1", true},
+		{"1pаssword", false}, // Cyrillic а (U+0430) swapped in for "a"
+		{"пароль", true},     // pure Cyrillic, single script
+	}
+
+	for _, tc := range cases {
+		if got := isSingleScriptLabel(tc.label); got != tc.want {
+			t.Errorf("isSingleScriptLabel(%q) = %v, want %v", tc.label, got, tc.want)
+		}
+	}
+}
+
+func TestParseAccountUrl(t *testing.T) {
+	cases := []struct {
+		name   string
+		in     string
+		wantOK bool
+	}{
+		{"bare account domain", "acme.1password.com", true},
+		{"with scheme", "https://acme.1password.ca", true},
+		{"root domain without subdomain", "1password.com", true},
+		{"unrelated domain with allowed TLD", "evil.com", false},
+		{"brand name squatting outside the domain stem", "phishing-1password.com", false},
+		{"disallowed TLD", "acme.1password.net", false},
+		{"homograph hostname", "acme.1pаssword.com", false}, // Cyrillic а
+		{"userinfo rejected", "user:pass@acme.1password.com", false},
+		{"path rejected", "acme.1password.com/evil", false},
+		{"query rejected", "acme.1password.com?x=1", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, _, code := ParseAccountUrl(tc.in)
+			if ok != tc.wantOK {
+				t.Errorf("ParseAccountUrl(%q) ok = %v (code %q), want %v", tc.in, ok, code, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestIsEmailHomographDomain(t *testing.T) {
+	ok, _, code := IsEmail("foo@1pаssword.com") // Cyrillic а
+	if ok {
+		t.Errorf("IsEmail with a homograph domain should be rejected, got code %q", code)
+	}
+}
+
+func TestIsEmailDisplayName(t *testing.T) {
+	ok, value, code := IsEmail("Foo Bar <foo@apple.com>")
+	if !ok {
+		t.Fatalf("IsEmail with RFC5322 display-name form should validate, got code %q", code)
+	}
+	if value != "foo@apple.com" {
+		t.Errorf("IsEmail normalized value = %q, want foo@apple.com", value)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/1Password/1password-teams-open-source/script/version"
+)
+
+func main() {
+	showVersion := flag.Bool("version", false, "print version and build info, then exit")
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Println(version.String())
+		return
+	}
+
+	// Logged once at startup, before any ParseInput call, so a parse
+	// discrepancy reported months later can be traced back to exactly
+	// which binary and host processed the submission.
+	log.Printf("info: %s host=%s", version.String(), Uname())
+
+	if err := run(os.Stdin); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run reads an issue body from r (email, account URL, and role - one per
+// line), validates it as an Application, and reports the outcome. It's
+// the minimal driver the GitHub Action invokes this binary with.
+func run(r io.Reader) error {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		_, value, _ := ParseInput(scanner.Text())
+		lines = append(lines, value)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	app := ParseApplication(lines)
+	errs := ValidateAndEmit(context.Background(), &app)
+	if len(errs) == 0 {
+		fmt.Println("application is valid")
+		return nil
+	}
+
+	for _, e := range errs {
+		fmt.Println(e.Error())
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Sanitizer strips markup down to plain text. IsRegularString routes
+// through whichever implementation DefaultSanitizer points at, so the
+// markup engine can be swapped (see the legacy_sanitizer build tag)
+// without touching validation logic.
+type Sanitizer interface {
+	Strip(raw string) (string, error)
+}
+
+var collapseSpacesRegex = regexp.MustCompile(`[ \t]+`)
+
+// collapseWhitespace trims leading and trailing space on each line, and
+// blank lines at either end of value, and collapses runs
+// of spaces/tabs to one, while preserving the newlines themselves.
+func collapseWhitespace(value string) string {
+	lines := strings.Split(value, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(collapseSpacesRegex.ReplaceAllString(line, " "))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func decodeEntities(value string) string {
+	return html.UnescapeString(value)
+}
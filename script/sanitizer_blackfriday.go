@@ -0,0 +1,29 @@
+//go:build legacy_sanitizer
+
+package main
+
+import (
+	"bytes"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/russross/blackfriday/v2"
+)
+
+// BlackfridaySanitizer is the original approach: render markdown to HTML,
+// then strip tags with goquery. Kept behind the legacy_sanitizer build tag
+// so the old behavior stays available for manual comparison against
+// BlueMondaySanitizer on a given input, without building it by default.
+type BlackfridaySanitizer struct{}
+
+func (BlackfridaySanitizer) Strip(raw string) (string, error) {
+	renderedHTML := blackfriday.Run([]byte(raw))
+
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(renderedHTML))
+	if err != nil {
+		return "", err
+	}
+
+	return collapseWhitespace(doc.Text()), nil
+}
+
+var DefaultSanitizer Sanitizer = BlackfridaySanitizer{}
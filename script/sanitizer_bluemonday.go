@@ -0,0 +1,27 @@
+//go:build !legacy_sanitizer
+
+package main
+
+import "github.com/microcosm-cc/bluemonday"
+
+// BlueMondaySanitizer strips all markup with bluemonday's strict policy -
+// it never tries to interpret HTML, so unlike the markdown-rendering path
+// it can't silently let raw HTML through or swallow a render error.
+type BlueMondaySanitizer struct{}
+
+var blueMondayPolicy = bluemonday.StrictPolicy()
+
+func (BlueMondaySanitizer) Strip(raw string) (string, error) {
+	// Decode entities before sanitizing so an escaped "&lt;script&gt;"
+	// gets neutralized by Sanitize rather than passed through, then decode
+	// again after: Sanitize HTML-escapes the surviving plain text (e.g.
+	// "Tom & Jerry" -> "Tom &amp; Jerry"), and by this point every tag has
+	// already been stripped, so it's safe to decode back to plain text.
+	stripped := blueMondayPolicy.Sanitize(decodeEntities(raw))
+	return collapseWhitespace(decodeEntities(stripped)), nil
+}
+
+// DefaultSanitizer is the fast path: strip tags outright rather than
+// rendering markdown to HTML first. Build with -tags legacy_sanitizer to
+// get the old blackfriday+goquery behavior instead, for parity checks.
+var DefaultSanitizer Sanitizer = BlueMondaySanitizer{}
@@ -0,0 +1,27 @@
+//go:build legacy_sanitizer
+
+package main
+
+import "testing"
+
+// FuzzBlackfridaySanitizerStrip mirrors FuzzSanitizerStrip for the legacy
+// blackfriday+goquery path, built with -tags legacy_sanitizer.
+func FuzzBlackfridaySanitizerStrip(f *testing.F) {
+	seeds := []string{
+		"",
+		"<div><span>nested <b>bold</b></span></div>",
+		"<div><p>unclosed",
+		"# heading\n\n* item\n* <img src=x onerror=alert(1)>",
+		"<!-- unterminated comment",
+		"<table><tr><td>cell",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		if _, err := (BlackfridaySanitizer{}).Strip(raw); err != nil {
+			t.Skip("sanitizer reported an error rather than panicking, which is fine")
+		}
+	})
+}
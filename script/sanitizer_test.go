@@ -0,0 +1,59 @@
+//go:build !legacy_sanitizer
+
+package main
+
+import "testing"
+
+func TestDefaultSanitizerStrip(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain text", "hello world", "hello world"},
+		{"strips tags", "<b>hello</b> world", "hello world"},
+		{"entities round-trip to plain text", "Tom & Jerry", "Tom & Jerry"},
+		{"comparison operators aren't re-escaped", "5 < 10 and 10 > 5", "5 < 10 and 10 > 5"},
+		{"script tag is removed, not just unwrapped", "<script>alert(1)</script>safe", "safe"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := DefaultSanitizer.Strip(tc.in)
+			if err != nil {
+				t.Fatalf("Strip(%q) returned error: %v", tc.in, err)
+			}
+			if got != tc.want {
+				t.Errorf("Strip(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+// FuzzSanitizerStrip exercises DefaultSanitizer with adversarial markup -
+// malformed markdown, nested HTML, unclosed tags - to make sure it never
+// panics, regardless of what a submitted issue body contains.
+func FuzzSanitizerStrip(f *testing.F) {
+	seeds := []string{
+		"",
+		"<div><span>nested <b>bold</b></span></div>",
+		"<div><p>unclosed",
+		"<script>alert(1)</script>",
+		"[link](javascript:alert(1))",
+		"# heading\n\n* item\n* <img src=x onerror=alert(1)>",
+		"<<<<<<<<<<<<<<<<",
+		"<a href=\"javascript:alert(1)\">click</a>",
+		"&lt;script&gt;alert(1)&lt;/script&gt;",
+		"<!-- unterminated comment",
+		"<table><tr><td>cell",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		if _, err := DefaultSanitizer.Strip(raw); err != nil {
+			t.Skip("sanitizer reported an error rather than panicking, which is fine")
+		}
+	})
+}
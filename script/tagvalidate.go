@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ValidatorFunc normalizes a raw field value and/or reports it as invalid.
+// It mirrors the (ok, value, message) shape of the legacy ValidatorCallback
+// helpers, minus the bool, since a non-nil error already signals failure.
+type ValidatorFunc func(value string) (string, error)
+
+var validatorRegistry = map[string]ValidatorFunc{}
+
+// RegisterValidator adds (or overrides) a named rule usable in `validate`
+// struct tags, e.g. `validate:"required,email"`.
+func RegisterValidator(name string, fn ValidatorFunc) {
+	validatorRegistry[name] = fn
+}
+
+func init() {
+	RegisterValidator("required", wrapLegacy(IsPresent))
+	RegisterValidator("email", wrapLegacy(IsEmail))
+	RegisterValidator("url", wrapLegacy(IsUrl))
+	RegisterValidator("oneP_url", wrapLegacy(func(value string) (bool, string, string) {
+		ok, parsed, msg := ParseAccountUrl(value)
+		return ok, parsed, msg
+	}))
+	RegisterValidator("project_role", wrapLegacy(IsProjectRole))
+	RegisterValidator("checked", wrapLegacy(IsChecked))
+	RegisterValidator("checkbox", wrapLegacy(func(value string) (bool, string, string) {
+		ok, parsed, msg := ParseCheckbox(value)
+		return ok, parsed, msg
+	}))
+	RegisterValidator("number", wrapLegacy(func(value string) (bool, string, string) {
+		ok, parsed, msg := ParseNumber(value)
+		return ok, fmt.Sprintf("%d", parsed), msg
+	}))
+	RegisterValidator("regular_string", wrapLegacy(IsRegularString))
+
+	RegisterValidator("trim", func(value string) (string, error) {
+		return strings.TrimSpace(value), nil
+	})
+	RegisterValidator("lower", func(value string) (string, error) {
+		return strings.ToLower(value), nil
+	})
+}
+
+// wrapLegacy adapts the existing (bool, string, string) callbacks into the
+// ValidatorFunc shape so the registry can reuse them as-is. The legacy
+// callbacks now return a code (e.g. "validation.email.invalid") as their
+// message slot, which wrapLegacy turns into a *ValidationError so callers
+// still get a stable ID alongside the rendered English text.
+func wrapLegacy(fn func(string) (bool, string, string)) ValidatorFunc {
+	return func(value string) (string, error) {
+		ok, parsed, code := fn(value)
+		if !ok {
+			return parsed, codedError(parsed, code, map[string]any{"value": parsed})
+		}
+		return parsed, nil
+	}
+}
+
+// rule is a single parsed step of a `validate` tag, e.g. "oneof=A,B,C".
+type rule struct {
+	name string
+	arg  string
+}
+
+func parseValidateTag(tag string) []rule {
+	var rules []rule
+	for _, stage := range strings.Split(tag, "|") {
+		rules = append(rules, parseStageRules(stage)...)
+	}
+	return rules
+}
+
+// parseStageRules splits one "|"-delimited stage into its comma-separated
+// rules. Once a rule's name is followed by "=", everything after that '='
+// to the end of the stage is its argument rather than further rules -
+// otherwise a comma-separated option list like `oneof=A,B,C` would itself
+// be split into bogus rules named "B" and "C". This means an argumented
+// rule must be the last rule in its stage; put it in its own "|" segment
+// to combine it with others, e.g. `required|oneof=A,B,C`.
+func parseStageRules(stage string) []rule {
+	var rules []rule
+
+	for stage != "" {
+		comma := strings.IndexByte(stage, ',')
+		eq := strings.IndexByte(stage, '=')
+
+		if eq != -1 && (comma == -1 || eq < comma) {
+			name := strings.TrimSpace(stage[:eq])
+			if name != "" {
+				rules = append(rules, rule{name: name, arg: stage[eq+1:]})
+			}
+			break
+		}
+
+		if comma == -1 {
+			name := strings.TrimSpace(stage)
+			if name != "" {
+				rules = append(rules, rule{name: name})
+			}
+			break
+		}
+
+		name := strings.TrimSpace(stage[:comma])
+		if name != "" {
+			rules = append(rules, rule{name: name})
+		}
+		stage = stage[comma+1:]
+	}
+
+	return rules
+}
+
+func lookupValidator(r rule) (ValidatorFunc, bool) {
+	if r.name == "oneof" {
+		return oneOfValidator(r.arg), true
+	}
+
+	fn, ok := validatorRegistry[r.name]
+	return fn, ok
+}
+
+func oneOfValidator(arg string) ValidatorFunc {
+	options := strings.Split(arg, ",")
+	return func(value string) (string, error) {
+		for _, option := range options {
+			if value == option {
+				return value, nil
+			}
+		}
+		return value, codedError(value, "validation.oneof.invalid", map[string]any{"options": strings.Join(options, ", ")})
+	}
+}
+
+// Validate walks the exported fields of a struct, applying each field's
+// `validate` tag in order, and returns the same []ValidationError shape the
+// Validator type has always produced. The section for an error defaults to
+// the field name, overridable with a `section:"..."` tag.
+func Validate(v any) []ValidationError {
+	var errs []ValidationError
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errs
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported field: Interface() would panic
+		}
+
+		tag, ok := field.Tag.Lookup("validate")
+		if !ok {
+			continue
+		}
+
+		section := field.Name
+		if s, ok := field.Tag.Lookup("section"); ok {
+			section = s
+		}
+
+		value := fmt.Sprintf("%v", rv.Field(i).Interface())
+
+		for _, r := range parseValidateTag(tag) {
+			fn, ok := lookupValidator(r)
+			if !ok {
+				ce := codedError(value, "validation.unknown_validator", map[string]any{"name": r.name})
+				errs = append(errs, ValidationError{Section: section, Value: value, ID: ce.ID, Params: ce.Params, Message: ce.Message})
+				break
+			}
+
+			parsed, err := fn(value)
+			if err != nil {
+				if ce, ok := err.(*ValidationError); ok {
+					errs = append(errs, ValidationError{Section: section, Value: value, ID: ce.ID, Params: ce.Params, Message: ce.Message})
+				} else {
+					errs = append(errs, ValidationError{Section: section, Value: value, Message: err.Error()})
+				}
+				break
+			}
+			value = parsed
+		}
+
+		if rv.Field(i).CanSet() && rv.Field(i).Kind() == reflect.String {
+			rv.Field(i).SetString(value)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,90 @@
+package main
+
+import "testing"
+
+func TestParseValidateTag(t *testing.T) {
+	cases := []struct {
+		name string
+		tag  string
+		want []rule
+	}{
+		{"single rule", "required", []rule{{name: "required"}}},
+		{"chained rules", "required,email", []rule{{name: "required"}, {name: "email"}}},
+		{"pipeline stages", "trim|lower", []rule{{name: "trim"}, {name: "lower"}}},
+		{
+			"oneof keeps its comma-separated argument intact",
+			"oneof=Founder or Owner,Team Member or Employee,Project Lead",
+			[]rule{{name: "oneof", arg: "Founder or Owner,Team Member or Employee,Project Lead"}},
+		},
+		{
+			"oneof combined with another rule via a pipe stage",
+			"required|oneof=A,B,C",
+			[]rule{{name: "required"}, {name: "oneof", arg: "A,B,C"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := parseValidateTag(tc.tag)
+			if len(got) != len(tc.want) {
+				t.Fatalf("parseValidateTag(%q) = %#v, want %#v", tc.tag, got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("parseValidateTag(%q)[%d] = %#v, want %#v", tc.tag, i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+type testApplication struct {
+	Email      string `validate:"required,email"`
+	AccountURL string `validate:"required|oneP_url" section:"Account URL"`
+	Role       string `validate:"required|oneof=Founder or Owner,Team Member or Employee"`
+	Unmapped   string `validate:"unknown_rule"`
+	unexported string `validate:"required"`
+}
+
+func TestValidate(t *testing.T) {
+	app := testApplication{
+		Email:      "me@example.com",
+		AccountURL: "acme.1password.com",
+		Role:       "Founder or Owner",
+		Unmapped:   "x",
+		unexported: "",
+	}
+
+	errs := Validate(&app)
+	if len(errs) != 1 {
+		t.Fatalf("Validate() = %#v, want exactly one error for the unknown validator", errs)
+	}
+	if errs[0].ID != "validation.unknown_validator" {
+		t.Errorf("error ID = %q, want validation.unknown_validator", errs[0].ID)
+	}
+	if errs[0].Section != "Unmapped" {
+		t.Errorf("error Section = %q, want Unmapped", errs[0].Section)
+	}
+}
+
+func TestValidateSectionTag(t *testing.T) {
+	app := testApplication{AccountURL: "not-1password.example"}
+	errs := Validate(&app)
+
+	found := false
+	for _, e := range errs {
+		if e.Section == "Account URL" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected an error sectioned by the `section` tag override, got %#v", errs)
+	}
+}
+
+func TestValidateSkipsUnexportedFields(t *testing.T) {
+	// unexported has a validate tag but must never be read via
+	// reflection - Validate must not panic.
+	app := testApplication{Email: "me@example.com", AccountURL: "acme.1password.com", Role: "Founder or Owner"}
+	_ = Validate(&app)
+}
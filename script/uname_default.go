@@ -0,0 +1,14 @@
+//go:build !linux
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Uname reports host details on platforms without a uname(2) syscall
+// (Darwin, Windows, ...), falling back to what the Go runtime knows.
+func Uname() string {
+	return fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+}
@@ -0,0 +1,38 @@
+//go:build linux
+
+package main
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// Uname reports the host kernel details via the uname(2) syscall, so a
+// validator run's logs can be tied back to the exact host that processed
+// a submission months after the fact.
+func Uname() string {
+	var uts syscall.Utsname
+
+	if err := syscall.Uname(&uts); err != nil {
+		return fmt.Sprintf("uname unavailable: %s", err)
+	}
+
+	return fmt.Sprintf(
+		"%s %s %s (%s)",
+		charsToString(uts.Sysname[:]),
+		charsToString(uts.Release[:]),
+		charsToString(uts.Version[:]),
+		charsToString(uts.Machine[:]),
+	)
+}
+
+func charsToString(chars []int8) string {
+	buf := make([]byte, 0, len(chars))
+	for _, c := range chars {
+		if c == 0 {
+			break
+		}
+		buf = append(buf, byte(c))
+	}
+	return string(buf)
+}
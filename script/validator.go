@@ -1,30 +1,32 @@
 package main
 
 import (
-	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/mail"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
-
-	"github.com/PuerkitoBio/goquery"
-	"github.com/russross/blackfriday/v2"
 )
 
 var (
-	accountUrlRegex = regexp.MustCompile(`^(https?:\/\/)?[\w.-]+\.1password\.(com|ca|eu)\/?$`)
-	urlRegex        = regexp.MustCompile(`https?://[^\s]+`)
-	emailRegex      = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
-	emojiRegex      = regexp.MustCompile(`[\x{1F300}-\x{1F5FF}\x{1F600}-\x{1F64F}\x{1F680}-\x{1F6FF}\x{1F700}-\x{1F77F}\x{1F780}-\x{1F7FF}\x{1F800}-\x{1F8FF}\x{1F900}-\x{1F9FF}\x{1FA00}-\x{1FA6F}\x{1FA70}-\x{1FAFF}\x{1FB00}-\x{1FBFF}]+`)
-	applicantRoles  = []string{"Founder or Owner", "Team Member or Employee", "Project Lead", "Core Maintainer", "Developer", "Organizer or Admin", "Program Manager"}
+	urlRegex       = regexp.MustCompile(`https?://[^\s]+`)
+	emailRegex     = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+	emojiRegex     = regexp.MustCompile(`[\x{1F300}-\x{1F5FF}\x{1F600}-\x{1F64F}\x{1F680}-\x{1F6FF}\x{1F700}-\x{1F77F}\x{1F780}-\x{1F7FF}\x{1F800}-\x{1F8FF}\x{1F900}-\x{1F9FF}\x{1FA00}-\x{1FA6F}\x{1FA70}-\x{1FAFF}\x{1FB00}-\x{1FBFF}]+`)
+	applicantRoles = []string{"Founder or Owner", "Team Member or Employee", "Project Lead", "Core Maintainer", "Developer", "Organizer or Admin", "Program Manager"}
 )
 
+// ValidationError describes a single failed field. ID is a stable,
+// machine-readable code (e.g. "validation.email.invalid") suitable for the
+// CLI/GitHub-action pipeline to key off of; Message is its default English
+// rendering so existing callers that only look at Message keep working.
 type ValidationError struct {
 	Section string
 	Value   string
 	Message string
+	ID      string
+	Params  map[string]any
 }
 
 type ValidatorCallback func(string) (bool, string, string)
@@ -33,6 +35,36 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Section, e.Message)
 }
 
+// Translate re-renders the error's Message using T instead of the built-in
+// English catalog, for hosts that plug in a go-i18n-compatible translator.
+func (e *ValidationError) Translate(T func(id string, params ...any) string) string {
+	if e.ID == "" {
+		return e.Message
+	}
+
+	args := make([]any, 0, len(e.Params)*2)
+	for name, value := range e.Params {
+		args = append(args, name, value)
+	}
+
+	return T(e.ID, args...)
+}
+
+// ToJSON round-trips a ValidationError so it can be posted as a structured
+// issue comment instead of pasted raw text.
+func (e *ValidationError) ToJSON() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// FromJSON is the inverse of ToJSON.
+func FromJSON(data []byte) (*ValidationError, error) {
+	var e ValidationError
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
 type Validator struct {
 	Errors []ValidationError
 }
@@ -45,6 +77,18 @@ func (v *Validator) AddError(section, value, message string) {
 	})
 }
 
+// AddCodedError records a failure by its stable ID, rendering the default
+// English Message from params via the built-in catalog.
+func (v *Validator) AddCodedError(section, value, id string, params map[string]any) {
+	v.Errors = append(v.Errors, ValidationError{
+		Section: section,
+		Value:   value,
+		ID:      id,
+		Params:  params,
+		Message: renderDefault(id, params),
+	})
+}
+
 func (v *Validator) HasError(section string) bool {
 	for _, err := range v.Errors {
 		if err.Section == section {
@@ -75,20 +119,12 @@ func ParseInput(value string) (bool, string, string) {
 }
 
 func ParseAccountUrl(value string) (bool, string, string) {
-	if accountUrlRegex.Match([]byte(value)) {
-		if !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
-			value = "https://" + value
-		}
-
-		u, err := url.Parse(value)
-		if err != nil {
-			return false, value, err.Error()
-		}
-
-		return true, u.Hostname(), ""
-	} else {
-		return false, value, "is an invalid 1Password account URL"
+	host, code := parseStrictAccountURL(value)
+	if code != "" {
+		return false, value, code
 	}
+
+	return true, host, ""
 }
 
 func ParseCheckbox(value string) (bool, string, string) {
@@ -100,7 +136,7 @@ func ParseCheckbox(value string) (bool, string, string) {
 		return true, "false", ""
 	}
 
-	return false, value, "could not parse checkbox"
+	return false, value, "validation.checkbox.unparseable"
 }
 
 func ParseNumber(value string) (bool, int, string) {
@@ -115,7 +151,7 @@ func ParseNumber(value string) (bool, int, string) {
 	parsedNumber, err := strconv.Atoi(cleanedString)
 
 	if err != nil {
-		return false, 0, "could not be parsed into a number"
+		return false, 0, "validation.number.unparseable"
 	}
 
 	return true, parsedNumber, ""
@@ -125,7 +161,7 @@ func ParseBool(value string) (bool, bool, string) {
 	parsedBool, err := strconv.ParseBool(value)
 
 	if err != nil {
-		return false, false, "could not be parsed into a boolean"
+		return false, false, "validation.bool.unparseable"
 	}
 
 	return true, parsedBool, ""
@@ -133,7 +169,7 @@ func ParseBool(value string) (bool, bool, string) {
 
 func IsPresent(value string) (bool, string, string) {
 	if value == "" {
-		return false, value, "is empty"
+		return false, value, "validation.required"
 	}
 
 	return true, value, ""
@@ -144,11 +180,21 @@ func IsEmail(value string) (bool, string, string) {
 		return true, value, ""
 	}
 
-	if _, err := mail.ParseAddress(value); err == nil {
-		return true, value, ""
+	addr, err := mail.ParseAddress(value)
+	if err != nil {
+		return false, value, "validation.email.invalid"
+	}
+
+	// Normalize the address mail.ParseAddress actually extracted, not the
+	// raw input: value may be RFC5322 "Display Name <addr>" form, and
+	// re-deriving the domain from raw with a bare "@" split would include
+	// the trailing ">".
+	normalized, code := normalizeEmailDomain(addr.Address)
+	if code != "" {
+		return false, value, code
 	}
 
-	return false, value, "is an invalid email"
+	return true, normalized, ""
 }
 
 func IsUrl(value string) (bool, string, string) {
@@ -158,35 +204,34 @@ func IsUrl(value string) (bool, string, string) {
 
 	parsedURL, err := url.ParseRequestURI(value)
 	if err != nil {
-		return false, value, "is an invalid URL"
+		return false, value, "validation.url.invalid"
 	}
 
 	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
-		return false, value, "must use \"http\" or \"https\" scheme"
+		return false, value, "validation.url.scheme"
 	}
 
 	return true, value, ""
 }
 
 func IsRegularString(value string) (bool, string, string) {
-	// strip all formattig, except for newlines
-	html := blackfriday.Run([]byte(value))
-	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(html))
+	stripped, err := DefaultSanitizer.Strip(value)
 	if err != nil {
-		return false, value, err.Error()
+		return false, value, "content.sanitize_failed"
 	}
-	value = strings.TrimSpace(doc.Text())
+	value = stripped
 
 	if urlRegex.MatchString(value) {
-		return false, value, "cannot contain URLs"
+		return false, value, "validation.string.has_url"
 	}
 
 	if emailRegex.MatchString(value) {
-		return false, value, "cannot contain email addresses"
+		return false, value, "validation.string.has_email"
 	}
 
-	if emojiRegex.MatchString(value) {
-		return false, value, "cannot contain emoji characters"
+	if err := DefaultContentPolicy.Scan(value); err != nil {
+		ce := err.(*ValidationError)
+		return false, value, ce.ID
 	}
 
 	return true, value, ""
@@ -199,12 +244,12 @@ func IsProjectRole(value string) (bool, string, string) {
 		}
 	}
 
-	return false, value, "is an invalid project role"
+	return false, value, "validation.project_role.invalid"
 }
 
 func IsChecked(value string) (bool, string, string) {
 	if value != "true" {
-		return false, value, "must be checked"
+		return false, value, "validation.checked.required"
 	}
 
 	return true, value, ""
@@ -0,0 +1,22 @@
+// Package version holds build-time metadata injected via `-ldflags -X`, so
+// logs from a validator run can be tied back to the exact binary and
+// commit that produced them.
+package version
+
+import "fmt"
+
+// These are overridden at build time, e.g.:
+//
+//	go build -ldflags "-X github.com/1Password/1password-teams-open-source/script/version.Version=v1.2.3 \
+//	  -X .../version.Commit=$(git rev-parse HEAD) -X .../version.Date=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+var (
+	Version = "dev"
+	Commit  = "unknown"
+	Date    = "unknown"
+)
+
+// String renders the build metadata as a single line suitable for a
+// startup log or a --version flag.
+func String() string {
+	return fmt.Sprintf("version=%s commit=%s built=%s", Version, Commit, Date)
+}